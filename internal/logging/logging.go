@@ -0,0 +1,109 @@
+// Package logging provides the shared structured-logging configuration used
+// by every rpc-gateway subsystem (proxy, health checker, HTTP server), so
+// operators have a single `logging:` YAML block instead of the DEBUG
+// environment variable.
+package logging
+
+import (
+	"log/slog"
+	"os"
+)
+
+// Config configures how a component logs: output format, minimum level, and
+// for HTTP logging, how much of the request to include.
+type Config struct {
+	// Format is one of "json", "text" or "logfmt". Defaults to "json".
+	Format string `yaml:"format"`
+
+	// Level is one of "debug", "info", "warn" or "error". Defaults to
+	// "info".
+	Level string `yaml:"level"`
+
+	// IncludeRequestHeaders logs the incoming request's headers. Only
+	// consulted for the http component.
+	IncludeRequestHeaders bool `yaml:"include_request_headers"`
+
+	// IncludeRequestBodyBytes logs up to this many bytes of the incoming
+	// request body, at debug level, so the body only appears when the
+	// http component's Level is "debug". Zero disables body logging. Only
+	// consulted for the http component.
+	IncludeRequestBodyBytes int `yaml:"include_request_body_bytes"`
+
+	// Proxy, HealthCheck and HTTP override Format/Level for that
+	// component. A nil override inherits the top-level settings.
+	Proxy       *Config `yaml:"proxy"`
+	HealthCheck *Config `yaml:"healthcheck"`
+	HTTP        *Config `yaml:"http"`
+}
+
+// For resolves the effective Config for a named component ("proxy",
+// "healthcheck" or "http"), falling back to the top-level settings for
+// anything the override doesn't set.
+func (c Config) For(component string) Config {
+	var override *Config
+
+	switch component {
+	case "proxy":
+		override = c.Proxy
+	case "healthcheck":
+		override = c.HealthCheck
+	case "http":
+		override = c.HTTP
+	}
+
+	if override == nil {
+		return c
+	}
+
+	resolved := *override
+	if resolved.Format == "" {
+		resolved.Format = c.Format
+	}
+	if resolved.Level == "" {
+		resolved.Level = c.Level
+	}
+	if !resolved.IncludeRequestHeaders {
+		resolved.IncludeRequestHeaders = c.IncludeRequestHeaders
+	}
+	if resolved.IncludeRequestBodyBytes == 0 {
+		resolved.IncludeRequestBodyBytes = c.IncludeRequestBodyBytes
+	}
+
+	return resolved
+}
+
+// Level returns the resolved slog.Level for this Config.
+func (c Config) Level() slog.Level {
+	return c.level()
+}
+
+func (c Config) level() slog.Level {
+	switch c.Level {
+	case "debug":
+		return slog.LevelDebug
+	case "warn":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// Handler builds the slog.Handler for this Config, writing to stderr. Both
+// "text" and "logfmt" use slog's key=value TextHandler; rpc-gateway doesn't
+// otherwise distinguish between them.
+func (c Config) Handler() slog.Handler {
+	opts := &slog.HandlerOptions{Level: c.level()}
+
+	if c.Format == "text" || c.Format == "logfmt" {
+		return slog.NewTextHandler(os.Stderr, opts)
+	}
+
+	return slog.NewJSONHandler(os.Stderr, opts)
+}
+
+// Logger builds a *slog.Logger for this Config.
+func (c Config) Logger() *slog.Logger {
+	return slog.New(c.Handler())
+}
@@ -0,0 +1,25 @@
+package proxy
+
+import "log/slog"
+
+// TargetConfig describes a single upstream RPC target in the failover pool.
+type TargetConfig struct {
+	Name string `yaml:"name"`
+	URL  string `yaml:"url"`
+}
+
+// ProxyConfig configures the reverse-proxy HTTP listener in front of the
+// target pool.
+type ProxyConfig struct {
+	Port string `yaml:"port"`
+}
+
+// Config is the full configuration required to build a Proxy.
+type Config struct {
+	Proxy              ProxyConfig
+	Targets            []TargetConfig
+	HealthChecks       HealthCheckerConfig
+	HealthcheckManager *HealthCheckManager
+	Name               string
+	Logger             *slog.Logger
+}
@@ -0,0 +1,84 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Health check types supported in a target's `checks:` list.
+const (
+	HealthCheckTypeGeth   = "geth"
+	HealthCheckTypeReth   = "reth"
+	HealthCheckTypeOpNode = "op_node"
+	HealthCheckTypeBeacon = "beacon"
+)
+
+// HealthCheckResult is the outcome of a single HealthCheck probe.
+type HealthCheckResult struct {
+	// Healthy reports whether this probe considers the target usable.
+	Healthy bool `json:"healthy"`
+
+	// Reason explains why Healthy is false. Empty when Healthy is true.
+	Reason string `json:"reason,omitempty"`
+}
+
+// HealthCheck is a single pluggable health signal that can be evaluated in
+// addition to the baseline eth_blockNumber probe performed by HealthChecker.
+// A target is considered healthy only when every configured HealthCheck
+// (plus the baseline probe) reports healthy.
+type HealthCheck interface {
+	// Name identifies the check, e.g. "execution" or "consensus". Used to
+	// report which signal caused a demotion.
+	Name() string
+
+	Check(ctx context.Context) (HealthCheckResult, error)
+}
+
+// HealthCheckConfig configures a single HealthCheck attached to a target,
+// e.g.:
+//
+//	checks:
+//	  - type: reth
+//	    minPeers: 3
+//	    maxBlocksBehind: 5
+//	  - type: op_node
+//	    minPeers: 8
+type HealthCheckConfig struct {
+	// Type selects the HealthCheck implementation. One of geth, reth,
+	// op_node, beacon.
+	Type string `yaml:"type"`
+
+	// MinPeers is the minimum peer count required by execution- and
+	// consensus-layer probes. Zero disables the peer-count requirement.
+	MinPeers uint64 `yaml:"minPeers"`
+
+	// MaxBlocksBehind rejects a target once its reported block/slot number
+	// falls more than this many blocks behind the highest value seen across
+	// the pool. Zero disables the lag check for this probe.
+	MaxBlocksBehind uint64 `yaml:"maxBlocksBehind"`
+
+	// Service is accepted in the config, but none of the built-in check
+	// types (geth, reth, op_node, beacon) reads it.
+	Service string `yaml:"service"`
+}
+
+// newHealthChecks builds the HealthCheck probes configured for a target.
+func newHealthChecks(configs []HealthCheckConfig, client *rpc.Client, httpClient *http.Client, url string, poolMaxBlockNumber func() uint64) ([]HealthCheck, error) {
+	checks := make([]HealthCheck, 0, len(configs))
+
+	for _, cfg := range configs {
+		switch cfg.Type {
+		case HealthCheckTypeGeth, HealthCheckTypeReth:
+			checks = append(checks, newExecutionHealthCheck(cfg, client, poolMaxBlockNumber))
+		case HealthCheckTypeOpNode, HealthCheckTypeBeacon:
+			checks = append(checks, newConsensusHealthCheck(cfg, client, httpClient, url, poolMaxBlockNumber))
+		default:
+			return nil, fmt.Errorf("unknown health check type %q", cfg.Type)
+		}
+	}
+
+	return checks, nil
+}
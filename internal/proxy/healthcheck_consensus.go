@@ -0,0 +1,169 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// consensusHealthCheck mirrors the op-node/beacon consensus-layer probe:
+// OP stack nodes are queried over JSON-RPC (optimism_syncStatus,
+// opp2p_peerStats), while beacon nodes are queried over their REST API
+// (eth/v1/node/syncing, eth/v1/node/peer_count).
+type consensusHealthCheck struct {
+	isBeacon           bool
+	client             *rpc.Client
+	httpClient         *http.Client
+	baseURL            string
+	minPeers           uint64
+	maxBlocksBehind    uint64
+	poolMaxBlockNumber func() uint64
+}
+
+func newConsensusHealthCheck(cfg HealthCheckConfig, client *rpc.Client, httpClient *http.Client, baseURL string, poolMaxBlockNumber func() uint64) *consensusHealthCheck {
+	return &consensusHealthCheck{
+		isBeacon:           cfg.Type == HealthCheckTypeBeacon,
+		client:             client,
+		httpClient:         httpClient,
+		baseURL:            strings.TrimSuffix(baseURL, "/"),
+		minPeers:           cfg.MinPeers,
+		maxBlocksBehind:    cfg.MaxBlocksBehind,
+		poolMaxBlockNumber: poolMaxBlockNumber,
+	}
+}
+
+func (c *consensusHealthCheck) Name() string {
+	return "consensus"
+}
+
+func (c *consensusHealthCheck) Check(ctx context.Context) (HealthCheckResult, error) {
+	if c.isBeacon {
+		return c.checkBeacon(ctx)
+	}
+
+	return c.checkOpNode(ctx)
+}
+
+// opSyncStatus is the subset of the optimism_syncStatus response we care
+// about. See the op-node RPC API for the full shape.
+type opSyncStatus struct {
+	UnsafeL2 struct {
+		Number uint64 `json:"number"`
+	} `json:"unsafe_l2"`
+}
+
+func (c *consensusHealthCheck) checkOpNode(ctx context.Context) (HealthCheckResult, error) {
+	var status opSyncStatus
+	if err := c.client.CallContext(ctx, &status, "optimism_syncStatus"); err != nil {
+		return HealthCheckResult{}, fmt.Errorf("optimism_syncStatus: %w", err)
+	}
+
+	if c.minPeers > 0 {
+		var peerStats struct {
+			Connected uint64 `json:"connected"`
+		}
+		if err := c.client.CallContext(ctx, &peerStats, "opp2p_peerStats"); err != nil {
+			return HealthCheckResult{}, fmt.Errorf("opp2p_peerStats: %w", err)
+		}
+
+		if peerStats.Connected < c.minPeers {
+			return HealthCheckResult{
+				Healthy: false,
+				Reason:  fmt.Sprintf("peer count %d below minimum %d", peerStats.Connected, c.minPeers),
+			}, nil
+		}
+	}
+
+	if reason, lagging := c.checkLag(status.UnsafeL2.Number); lagging {
+		return HealthCheckResult{Healthy: false, Reason: reason}, nil
+	}
+
+	return HealthCheckResult{Healthy: true}, nil
+}
+
+func (c *consensusHealthCheck) checkBeacon(ctx context.Context) (HealthCheckResult, error) {
+	var syncing struct {
+		Data struct {
+			HeadSlot  string `json:"head_slot"`
+			IsSyncing bool   `json:"is_syncing"`
+		} `json:"data"`
+	}
+	if err := c.getJSON(ctx, "/eth/v1/node/syncing", &syncing); err != nil {
+		return HealthCheckResult{}, fmt.Errorf("eth/v1/node/syncing: %w", err)
+	}
+
+	if syncing.Data.IsSyncing {
+		return HealthCheckResult{Healthy: false, Reason: "node is syncing"}, nil
+	}
+
+	if c.minPeers > 0 {
+		var peerCount struct {
+			Data struct {
+				Connected string `json:"connected"`
+			} `json:"data"`
+		}
+		if err := c.getJSON(ctx, "/eth/v1/node/peer_count", &peerCount); err != nil {
+			return HealthCheckResult{}, fmt.Errorf("eth/v1/node/peer_count: %w", err)
+		}
+
+		connected, err := strconv.ParseUint(peerCount.Data.Connected, 10, 64)
+		if err != nil {
+			return HealthCheckResult{}, fmt.Errorf("parsing peer count: %w", err)
+		}
+
+		if connected < c.minPeers {
+			return HealthCheckResult{
+				Healthy: false,
+				Reason:  fmt.Sprintf("peer count %d below minimum %d", connected, c.minPeers),
+			}, nil
+		}
+	}
+
+	headSlot, err := strconv.ParseUint(syncing.Data.HeadSlot, 10, 64)
+	if err != nil {
+		return HealthCheckResult{}, fmt.Errorf("parsing head slot: %w", err)
+	}
+
+	if reason, lagging := c.checkLag(headSlot); lagging {
+		return HealthCheckResult{Healthy: false, Reason: reason}, nil
+	}
+
+	return HealthCheckResult{Healthy: true}, nil
+}
+
+func (c *consensusHealthCheck) checkLag(number uint64) (string, bool) {
+	if c.maxBlocksBehind == 0 || c.poolMaxBlockNumber == nil {
+		return "", false
+	}
+
+	maxBlock := c.poolMaxBlockNumber()
+	if maxBlock > number && maxBlock-number > c.maxBlocksBehind {
+		return fmt.Sprintf("block number %d is %d blocks behind pool max %d", number, maxBlock-number, maxBlock), true
+	}
+
+	return "", false
+}
+
+func (c *consensusHealthCheck) getJSON(ctx context.Context, path string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,78 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// executionHealthCheck mirrors the geth/reth execution-layer probe from the
+// flashbots node-healthchecker: the node must not report itself as syncing,
+// must have at least MinPeers peers, and must not lag more than
+// MaxBlocksBehind blocks behind the highest block number seen across the
+// pool.
+type executionHealthCheck struct {
+	client             *rpc.Client
+	minPeers           uint64
+	maxBlocksBehind    uint64
+	poolMaxBlockNumber func() uint64
+}
+
+func newExecutionHealthCheck(cfg HealthCheckConfig, client *rpc.Client, poolMaxBlockNumber func() uint64) *executionHealthCheck {
+	return &executionHealthCheck{
+		client:             client,
+		minPeers:           cfg.MinPeers,
+		maxBlocksBehind:    cfg.MaxBlocksBehind,
+		poolMaxBlockNumber: poolMaxBlockNumber,
+	}
+}
+
+func (e *executionHealthCheck) Name() string {
+	return "execution"
+}
+
+func (e *executionHealthCheck) Check(c context.Context) (HealthCheckResult, error) {
+	// eth_syncing returns the boolean `false` when the node is caught up,
+	// or an object describing sync progress otherwise.
+	var syncing json.RawMessage
+	if err := e.client.CallContext(c, &syncing, "eth_syncing"); err != nil {
+		return HealthCheckResult{}, fmt.Errorf("eth_syncing: %w", err)
+	}
+
+	if string(syncing) != "false" {
+		return HealthCheckResult{Healthy: false, Reason: "node is syncing"}, nil
+	}
+
+	if e.minPeers > 0 {
+		var peerCount hexutil.Uint64
+		if err := e.client.CallContext(c, &peerCount, "net_peerCount"); err != nil {
+			return HealthCheckResult{}, fmt.Errorf("net_peerCount: %w", err)
+		}
+
+		if uint64(peerCount) < e.minPeers {
+			return HealthCheckResult{
+				Healthy: false,
+				Reason:  fmt.Sprintf("peer count %d below minimum %d", uint64(peerCount), e.minPeers),
+			}, nil
+		}
+	}
+
+	if e.maxBlocksBehind > 0 && e.poolMaxBlockNumber != nil {
+		var blockNumber hexutil.Uint64
+		if err := e.client.CallContext(c, &blockNumber, "eth_blockNumber"); err != nil {
+			return HealthCheckResult{}, fmt.Errorf("eth_blockNumber: %w", err)
+		}
+
+		if maxBlock := e.poolMaxBlockNumber(); maxBlock > uint64(blockNumber) && maxBlock-uint64(blockNumber) > e.maxBlocksBehind {
+			return HealthCheckResult{
+				Healthy: false,
+				Reason:  fmt.Sprintf("block number %d is %d blocks behind pool max %d", uint64(blockNumber), maxBlock-uint64(blockNumber), maxBlock),
+			}, nil
+		}
+	}
+
+	return HealthCheckResult{Healthy: true}, nil
+}
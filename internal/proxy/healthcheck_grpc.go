@@ -0,0 +1,96 @@
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// HealthCheckModeGRPC switches a target from the default JSON-RPC
+// (eth_blockNumber + Checks) probing to the standard
+// grpc.health.v1.Health/Check RPC, for backends fronted by a gRPC service
+// rather than JSON-RPC over HTTP.
+const HealthCheckModeGRPC = "grpc"
+
+// GRPCConfig configures the grpc.health.v1 probe used when
+// HealthCheckerConfig.Mode is HealthCheckModeGRPC.
+type GRPCConfig struct {
+	// Service is passed as HealthCheckRequest.Service. Empty checks the
+	// server's overall status rather than a specific service.
+	Service string `yaml:"service"`
+
+	TLS TLSConfig `yaml:"tls"`
+}
+
+// TLSConfig configures TLS for a gRPC health probe's connection.
+type TLSConfig struct {
+	Enabled            bool   `yaml:"enabled"`
+	InsecureSkipVerify bool   `yaml:"insecureSkipVerify"`
+	CACertFile         string `yaml:"caCertFile"`
+}
+
+// grpcHealthCheck performs the standard grpc.health.v1.Health/Check RPC
+// against a target.
+type grpcHealthCheck struct {
+	conn    *grpc.ClientConn
+	client  healthpb.HealthClient
+	service string
+}
+
+func newGRPCHealthCheck(target string, config GRPCConfig) (*grpcHealthCheck, error) {
+	creds := insecure.NewCredentials()
+
+	if config.TLS.Enabled {
+		tlsConfig := &tls.Config{InsecureSkipVerify: config.TLS.InsecureSkipVerify} //nolint:gosec
+
+		if config.TLS.CACertFile != "" {
+			pem, err := os.ReadFile(config.TLS.CACertFile)
+			if err != nil {
+				return nil, fmt.Errorf("reading CA cert file %q: %w", config.TLS.CACertFile, err)
+			}
+
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("failed to parse CA cert file %q", config.TLS.CACertFile)
+			}
+
+			tlsConfig.RootCAs = pool
+		}
+
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("dialing grpc target %q: %w", target, err)
+	}
+
+	return &grpcHealthCheck{
+		conn:    conn,
+		client:  healthpb.NewHealthClient(conn),
+		service: config.Service,
+	}, nil
+}
+
+// isServing performs a single Health/Check RPC. SERVING is the only status
+// treated as healthy; NOT_SERVING, UNKNOWN, SERVICE_UNKNOWN and any
+// non-OK gRPC status are treated as failure.
+func (g *grpcHealthCheck) isServing(c context.Context) (bool, error) {
+	resp, err := g.client.Check(c, &healthpb.HealthCheckRequest{Service: g.service})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.GetStatus() == healthpb.HealthCheckResponse_SERVING, nil
+}
+
+func (g *grpcHealthCheck) Close() error {
+	return g.conn.Close()
+}
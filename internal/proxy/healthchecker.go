@@ -35,6 +35,26 @@ type HealthCheckerConfig struct {
 
 	// Minimum consecutive successes required to mark as healthy
 	SuccessThreshold uint `yaml:"successThreshold"`
+
+	// Mode selects how this target is probed. Empty (the default) speaks
+	// JSON-RPC (eth_blockNumber + Checks) against URL. HealthCheckModeGRPC
+	// instead performs a grpc.health.v1.Health/Check RPC against URL,
+	// honouring GRPC below, FailureThreshold and SuccessThreshold.
+	Mode string `yaml:"mode"`
+
+	// GRPC configures the probe used when Mode is HealthCheckModeGRPC.
+	GRPC GRPCConfig `yaml:"grpc"`
+
+	// Checks are additional health signals evaluated alongside the
+	// baseline eth_blockNumber probe, e.g. execution- or consensus-layer
+	// peer/sync checks. A target is healthy only when all of them pass.
+	// Not used in HealthCheckModeGRPC mode.
+	Checks []HealthCheckConfig `yaml:"checks"`
+
+	// PoolMaxBlockNumber, when set, is used by Checks that reject a
+	// target once it falls too far behind the rest of the pool. Wired up
+	// by HealthCheckManager after all checkers for a pool are created.
+	PoolMaxBlockNumber func() uint64 `yaml:"-"`
 }
 
 type HealthChecker struct {
@@ -48,13 +68,82 @@ type HealthChecker struct {
 	// gasLimit received from the GasLeft.sol contract call.
 	gasLimit uint64
 
-	// is the ethereum RPC node healthy according to the RPCHealthchecker
+	// is the ethereum RPC node healthy according to the RPCHealthchecker.
+	// In HealthCheckModeGRPC this is set directly by the grpc probe; in
+	// the default JSON-RPC mode it's the AND of blockHealthy and
+	// pluggableHealthy, kept in sync by recomputeHealth.
 	isHealthy bool
 
+	// blockHealthy is whether the baseline eth_blockNumber probe has
+	// crossed FailureThreshold/SuccessThreshold. Unused in
+	// HealthCheckModeGRPC.
+	blockHealthy bool
+	// pluggableHealthy is whether the configured Checks have crossed
+	// FailureThreshold/SuccessThreshold. Always true when no Checks are
+	// configured. Unused in HealthCheckModeGRPC.
+	pluggableHealthy bool
+
+	// checks are the additional pluggable health signals configured for
+	// this target, e.g. execution- or consensus-layer probes.
+	checks []HealthCheck
+
+	// checkResults holds the latest result of each check in checks, keyed
+	// by HealthCheck.Name(), so operators can see which signal caused a
+	// demotion.
+	checkResults map[string]HealthCheckResult
+
+	// lastCheckedAt is when the most recent probe (eth_blockNumber, or the
+	// grpc Health/Check in HealthCheckModeGRPC) started.
+	lastCheckedAt time.Time
+	// latency is how long the most recent probe (eth_blockNumber, or the
+	// grpc Health/Check in HealthCheckModeGRPC) took.
+	latency time.Duration
+	// consecutiveFailures counts the current streak of failed probes
+	// (eth_blockNumber, or the grpc Health/Check in HealthCheckModeGRPC),
+	// reset to 0 on success.
+	consecutiveFailures uint
+	// consecutiveSuccesses counts the current streak of successful probes
+	// (eth_blockNumber, or the grpc Health/Check in HealthCheckModeGRPC),
+	// reset to 0 on failure.
+	consecutiveSuccesses uint
+
+	// consecutivePluggableFailures counts the current streak of
+	// failing Checks rounds, reset to 0 once every check passes in the
+	// same round. Separate from consecutiveFailures, which tracks the
+	// eth_blockNumber probe.
+	consecutivePluggableFailures uint
+	// consecutivePluggableSuccesses counts the current streak of rounds
+	// where every check passed, reset to 0 on any failure.
+	consecutivePluggableSuccesses uint
+
+	// grpc is set instead of client/checks when config.Mode is
+	// HealthCheckModeGRPC.
+	grpc *grpcHealthCheck
+
 	mu sync.RWMutex
 }
 
 func NewHealthChecker(config HealthCheckerConfig, networkName string) (*HealthChecker, error) {
+	logger := config.Logger.With(
+		"provider", config.Name).With(
+		"network", networkName).With(
+		"process", "healthcheck",
+	)
+
+	if config.Mode == HealthCheckModeGRPC {
+		grpcCheck, err := newGRPCHealthCheck(config.URL, config.GRPC)
+		if err != nil {
+			return nil, err
+		}
+
+		return &HealthChecker{
+			logger:    logger,
+			config:    config,
+			isHealthy: true,
+			grpc:      grpcCheck,
+		}, nil
+	}
+
 	client, err := rpc.Dial(config.URL)
 	if err != nil {
 		return nil, err
@@ -62,18 +151,23 @@ func NewHealthChecker(config HealthCheckerConfig, networkName string) (*HealthCh
 
 	client.SetHeader("User-Agent", userAgent)
 
-	logger := config.Logger.With(
-		"provider", config.Name).With(
-		"network", networkName).With(
-		"process", "healthcheck",
-	)
+	httpClient := &http.Client{}
+
+	checks, err := newHealthChecks(config.Checks, client, httpClient, config.URL, config.PoolMaxBlockNumber)
+	if err != nil {
+		return nil, err
+	}
 
 	healthchecker := &HealthChecker{
-		logger:     logger,
-		client:     client,
-		httpClient: &http.Client{},
-		config:     config,
-		isHealthy:  true,
+		logger:           logger,
+		client:           client,
+		httpClient:       httpClient,
+		config:           config,
+		isHealthy:        true,
+		blockHealthy:     true,
+		pluggableHealthy: true,
+		checks:           checks,
+		checkResults:     make(map[string]HealthCheckResult, len(checks)),
 	}
 
 	return healthchecker, nil
@@ -125,12 +219,102 @@ func (h *HealthChecker) checkGasLimit(c context.Context) (uint64, error) {
 // - `eth_call` - to get the gas limit
 // And sets the health status based on the responses.
 func (h *HealthChecker) CheckAndSetHealth() {
+	if h.grpc != nil {
+		go h.checkAndSetGRPCHealth()
+		return
+	}
+
 	go h.checkAndSetBlockNumberHealth()
+	go h.checkAndSetPluggableHealth()
 
 	// Not being used for now as it requires on-chain setup
 	//	go h.checkAndSetGasLeftHealth()
 }
 
+// checkAndSetGRPCHealth performs a grpc.health.v1.Health/Check RPC and
+// marks the target healthy or unhealthy once it has crossed
+// SuccessThreshold or FailureThreshold consecutive results, matching the
+// threshold semantics of the default JSON-RPC mode.
+func (h *HealthChecker) checkAndSetGRPCHealth() {
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(h.config.Timeout))
+	defer cancel()
+
+	h.probeGRPCHealth(c)
+}
+
+// checkAndSetPluggableHealth runs every configured HealthCheck and marks the
+// target healthy only when all of them pass. The per-check results are kept
+// around so operators can see which signal caused a demotion.
+func (h *HealthChecker) checkAndSetPluggableHealth() {
+	if len(h.checks) == 0 {
+		return
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(h.config.Timeout))
+	defer cancel()
+
+	h.probePluggableHealth(c)
+}
+
+// probePluggableHealth runs every configured HealthCheck against ctx and
+// marks the target healthy or unhealthy once it has crossed
+// SuccessThreshold or FailureThreshold consecutive passing/failing rounds,
+// matching the threshold semantics of the other probe modes. Split out from
+// checkAndSetPluggableHealth so callers that already have a bounded context
+// (e.g. a forced /health probe) don't have their deadline silently replaced
+// by config.Timeout.
+func (h *HealthChecker) probePluggableHealth(c context.Context) {
+	results := make(map[string]HealthCheckResult, len(h.checks))
+	healthy := true
+
+	for _, check := range h.checks {
+		result, err := check.Check(c)
+		if err != nil {
+			h.logger.Error("health check failed", "check", check.Name(), "error", err)
+			result = HealthCheckResult{Healthy: false, Reason: err.Error()}
+		} else if !result.Healthy {
+			h.logger.Warn("health check reported unhealthy", "check", check.Name(), "reason", result.Reason)
+		}
+
+		results[check.Name()] = result
+		healthy = healthy && result.Healthy
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.checkResults = results
+
+	if !healthy {
+		h.consecutivePluggableSuccesses = 0
+		h.consecutivePluggableFailures++
+
+		if h.consecutivePluggableFailures >= max(h.config.FailureThreshold, 1) {
+			h.pluggableHealthy = false
+			h.recomputeHealth()
+		}
+
+		return
+	}
+
+	h.consecutivePluggableFailures = 0
+	h.consecutivePluggableSuccesses++
+
+	if h.consecutivePluggableSuccesses >= max(h.config.SuccessThreshold, 1) {
+		h.pluggableHealthy = true
+		h.recomputeHealth()
+	}
+}
+
+// recomputeHealth combines the baseline eth_blockNumber probe's result
+// (blockHealthy) with the pluggable Checks' result (pluggableHealthy): a
+// target is healthy only when both agree it is, matching the "every
+// configured HealthCheck (plus the baseline probe)" contract HealthCheck
+// documents. Must be called with h.mu held. Unused in HealthCheckModeGRPC,
+// where isHealthy is set directly by the grpc probe.
+func (h *HealthChecker) recomputeHealth() {
+	h.isHealthy = h.blockHealthy && h.pluggableHealthy
+}
+
 func (h *HealthChecker) checkAndSetBlockNumberHealth() {
 	c, cancel := context.WithTimeout(context.Background(), time.Duration(h.config.Timeout))
 	defer cancel()
@@ -140,14 +324,103 @@ func (h *HealthChecker) checkAndSetBlockNumberHealth() {
 	// This should be moved to a different place, because it does not do a
 	// health checking but it provides additional context.
 
+	h.probeBlockNumber(c)
+}
+
+// probeBlockNumber fetches and stores the current block number against ctx,
+// marking the target healthy or unhealthy once it has crossed
+// SuccessThreshold or FailureThreshold consecutive results, the same
+// threshold semantics as the other probe modes. Split out from
+// checkAndSetBlockNumberHealth for the same reason as probePluggableHealth
+// above.
+func (h *HealthChecker) probeBlockNumber(c context.Context) {
+	start := time.Now()
 	blockNumber, err := h.checkBlockNumber(c)
+	latency := time.Since(start)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.lastCheckedAt = start
+	h.latency = latency
+
 	if err != nil {
+		h.consecutiveSuccesses = 0
+		h.consecutiveFailures++
+
+		if h.consecutiveFailures >= max(h.config.FailureThreshold, 1) {
+			h.blockHealthy = false
+			h.recomputeHealth()
+		}
+
+		return
+	}
+
+	h.consecutiveFailures = 0
+	h.consecutiveSuccesses++
+	h.blockNumber = blockNumber
+
+	if h.consecutiveSuccesses >= max(h.config.SuccessThreshold, 1) {
+		h.blockHealthy = true
+		h.recomputeHealth()
+	}
+}
+
+// Probe performs a synchronous health check round bounded by ctx and returns
+// once it completes, rather than firing the usual background goroutines.
+// Used by the /health endpoint's full (non-quick) mode.
+func (h *HealthChecker) Probe(c context.Context) {
+	if h.grpc != nil {
+		h.probeGRPCHealth(c)
 		return
 	}
 
+	h.probeBlockNumber(c)
+	h.probePluggableHealth(c)
+}
+
+// probeGRPCHealth performs a single grpc.health.v1.Health/Check RPC against
+// ctx and marks the target healthy or unhealthy once it has crossed
+// SuccessThreshold or FailureThreshold consecutive results, the same
+// threshold state machine checkAndSetGRPCHealth drives off the periodic
+// ticker. Split out from checkAndSetGRPCHealth so callers that already
+// have a bounded context (e.g. a forced /health probe) don't have their
+// deadline silently replaced by config.Timeout, and so a forced probe
+// can't flip routing on a single transient result.
+func (h *HealthChecker) probeGRPCHealth(c context.Context) {
+	start := time.Now()
+	serving, err := h.grpc.isServing(c)
+	latency := time.Since(start)
+
 	h.mu.Lock()
 	defer h.mu.Unlock()
-	h.blockNumber = blockNumber
+
+	h.lastCheckedAt = start
+	h.latency = latency
+
+	if err != nil {
+		h.logger.Error("grpc health check failed", "error", err)
+	} else if !serving {
+		h.logger.Warn("grpc health check reported not serving")
+	}
+
+	if err != nil || !serving {
+		h.consecutiveSuccesses = 0
+		h.consecutiveFailures++
+
+		if h.consecutiveFailures >= max(h.config.FailureThreshold, 1) {
+			h.isHealthy = false
+		}
+
+		return
+	}
+
+	h.consecutiveFailures = 0
+	h.consecutiveSuccesses++
+
+	if h.consecutiveSuccesses >= max(h.config.SuccessThreshold, 1) {
+		h.isHealthy = true
+	}
 }
 
 // nolint: unused
@@ -170,7 +443,7 @@ func (h *HealthChecker) checkAndSetGasLeftHealth() {
 func (h *HealthChecker) Start(c context.Context) {
 	h.CheckAndSetHealth()
 
-	ticker := time.NewTicker(time.Duration(h.config.Interval))
+	ticker := time.NewTicker(time.Duration(h.interval()))
 	defer ticker.Stop()
 
 	for {
@@ -179,11 +452,64 @@ func (h *HealthChecker) Start(c context.Context) {
 			return
 		case <-ticker.C:
 			h.CheckAndSetHealth()
+
+			// Pick up an interval changed by a concurrent
+			// UpdateConfig (e.g. a hot config reload) without
+			// restarting this loop.
+			if interval := h.interval(); interval != time.Duration(0) {
+				ticker.Reset(interval)
+			}
 		}
 	}
 }
 
+func (h *HealthChecker) interval() time.Duration {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return time.Duration(h.config.Interval)
+}
+
+// UpdateConfig replaces this checker's configuration in place - interval,
+// timeout, thresholds and checks (or, in HealthCheckModeGRPC, the grpc
+// connection itself, so a changed URL/TLS/service takes effect) - without
+// restarting the Start loop or losing the target's current health state.
+// Used by HealthCheckManager.Reload to apply a hot config reload.
+func (h *HealthChecker) UpdateConfig(config HealthCheckerConfig) error {
+	if h.grpc != nil {
+		grpcCheck, err := newGRPCHealthCheck(config.URL, config.GRPC)
+		if err != nil {
+			return err
+		}
+
+		h.mu.Lock()
+		old := h.grpc
+		h.config = config
+		h.grpc = grpcCheck
+		h.mu.Unlock()
+
+		return old.Close()
+	}
+
+	checks, err := newHealthChecks(config.Checks, h.client, h.httpClient, config.URL, config.PoolMaxBlockNumber)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.config = config
+	h.checks = checks
+
+	return nil
+}
+
 func (h *HealthChecker) Stop(_ context.Context) error {
+	if h.grpc != nil {
+		return h.grpc.Close()
+	}
+
 	// TODO: Additional cleanups?
 	return nil
 }
@@ -202,6 +528,46 @@ func (h *HealthChecker) BlockNumber() uint64 {
 	return h.blockNumber
 }
 
+// LastCheckedAt returns when the most recent probe started.
+func (h *HealthChecker) LastCheckedAt() time.Time {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.lastCheckedAt
+}
+
+// Latency returns how long the most recent eth_blockNumber probe (or grpc
+// Health/Check in HealthCheckModeGRPC) took.
+func (h *HealthChecker) Latency() time.Duration {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.latency
+}
+
+// ConsecutiveFailures returns the current streak of failed eth_blockNumber
+// probes (or grpc Health/Check probes in HealthCheckModeGRPC).
+func (h *HealthChecker) ConsecutiveFailures() uint {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.consecutiveFailures
+}
+
+// CheckResults returns the latest result of every configured HealthCheck,
+// keyed by check name.
+func (h *HealthChecker) CheckResults() map[string]HealthCheckResult {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	results := make(map[string]HealthCheckResult, len(h.checkResults))
+	for name, result := range h.checkResults {
+		results[name] = result
+	}
+
+	return results
+}
+
 func (h *HealthChecker) GasLimit() uint64 {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
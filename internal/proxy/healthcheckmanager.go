@@ -0,0 +1,541 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/sygmaprotocol/rpc-gateway/internal/util"
+
+	"github.com/pkg/errors"
+)
+
+// defaultPoolEvalInterval is how often the pool-level evaluator re-checks
+// block lag and reorgs when HealthCheckerConfig.Interval isn't set.
+const defaultPoolEvalInterval = 15 * time.Second
+
+// HealthCheckManagerConfig is the configuration for a HealthCheckManager: the
+// set of targets to watch and the common HealthChecker settings (interval,
+// timeout, thresholds, checks) applied to each of them.
+type HealthCheckManagerConfig struct {
+	Targets []TargetConfig
+	Config  HealthCheckerConfig
+	Pool    PoolConfig
+	Logger  *slog.Logger
+}
+
+// PoolConfig configures the pool-level evaluator that ejects a target when
+// it falls too far behind the rest of the pool, or when its reported block
+// number regresses (a reorg). This is evaluated across all targets, on top
+// of each HealthChecker's own per-target probes.
+type PoolConfig struct {
+	// MaxBlocksBehind ejects a target once it's reported more than this
+	// many blocks behind the highest block number seen among the pool's other healthy
+	// targets. Zero disables lag-based ejection.
+	MaxBlocksBehind uint64 `yaml:"maxBlocksBehind"`
+
+	// MinQuorum is the minimum number of healthy targets required before
+	// lag-based ejection is applied, so a single stalled node reporting a
+	// higher block number than reality can't eject the rest of a small
+	// pool. Values below 1 are treated as 1.
+	MinQuorum int `yaml:"minQuorum"`
+
+	// ReorgTolerance allows a target's block number to decrease by up to
+	// this many blocks between evaluation rounds without being treated as
+	// a reorg.
+	ReorgTolerance uint64 `yaml:"reorgTolerance"`
+
+	// Cooldown is how long a target stays ejected after a reorg is
+	// detected before it's eligible to be re-evaluated. Zero (the
+	// default) still holds the target ejected until at least the next
+	// evaluation round, rather than disabling reorg ejection.
+	Cooldown util.DurationUnmarshalled `yaml:"cooldown"`
+}
+
+// TargetStatus is a single target's health as seen by HealthCheckManager,
+// including the per-check breakdown so operators can see which signal
+// caused a demotion.
+type TargetStatus struct {
+	Name                string                       `json:"name"`
+	Healthy             bool                         `json:"healthy"`
+	LastCheckedAt       time.Time                    `json:"lastCheckedAt"`
+	Latency             int64                        `json:"latencyMs"`
+	BlockNumber         uint64                       `json:"blockNumber"`
+	BlockLag            uint64                       `json:"blockLag"`
+	PoolMaxBlock        uint64                       `json:"poolMaxBlock"`
+	ConsecutiveFailures uint                         `json:"consecutiveFailures"`
+	EjectedReason       string                       `json:"ejectedReason,omitempty"`
+	Checks              map[string]HealthCheckResult `json:"checks,omitempty"`
+}
+
+// managedChecker pairs a HealthChecker with the cancel func for the
+// goroutine running its Start loop, so HealthCheckManager.Reload can stop
+// checkers for targets that were removed from the config. It also carries
+// the pool evaluator's ejection state for this target: lagEjected* is
+// recomputed every evaluation round, while reorgEjected* latches for
+// Pool.Cooldown once a regression is detected.
+type managedChecker struct {
+	checker *HealthChecker
+	cancel  context.CancelFunc
+
+	lastBlockNumber uint64
+
+	lagEjected       bool
+	lagEjectedReason string
+
+	reorgEjectedUntil  time.Time
+	reorgEjectedReason string
+}
+
+// ejectedReason returns why the pool evaluator has ejected this target from
+// the pool, or "" if it hasn't.
+func (mc *managedChecker) ejectedReason(now time.Time) string {
+	if now.Before(mc.reorgEjectedUntil) {
+		return mc.reorgEjectedReason
+	}
+
+	if mc.lagEjected {
+		return mc.lagEjectedReason
+	}
+
+	return ""
+}
+
+// HealthCheckManager owns a HealthChecker per target and runs them for the
+// lifetime of a gateway. Its target set can be changed at runtime via
+// Reload, e.g. in response to a SIGHUP-triggered config reload.
+type HealthCheckManager struct {
+	name    string
+	logger  *slog.Logger
+	ctx     context.Context
+	wg      sync.WaitGroup
+	started bool
+
+	checkers map[string]*managedChecker
+
+	// order lists checkers' names in config order, so Status/Probe can
+	// report targets in a deterministic order (map iteration order is
+	// randomized) instead of an arbitrary one that changes call to call.
+	order []string
+
+	// checkInterval is how often the pool evaluator re-runs, mirrored
+	// from the per-target HealthCheckerConfig.Interval.
+	checkInterval time.Duration
+	pool          PoolConfig
+	poolCancel    context.CancelFunc
+
+	mu sync.RWMutex
+}
+
+// NewHealthCheckManager creates a HealthChecker for every target in config
+// and wires them together so that checks depending on pool-wide state (e.g.
+// MaxBlocksBehind) can see the other targets' block numbers.
+func NewHealthCheckManager(config HealthCheckManagerConfig, networkName string) (*HealthCheckManager, error) {
+	m := &HealthCheckManager{
+		name:          networkName,
+		logger:        config.Logger,
+		checkers:      make(map[string]*managedChecker, len(config.Targets)),
+		order:         make([]string, 0, len(config.Targets)),
+		checkInterval: time.Duration(config.Config.Interval),
+		pool:          config.Pool,
+	}
+
+	for _, target := range config.Targets {
+		checker, err := m.newChecker(config.Config, target)
+		if err != nil {
+			return nil, err
+		}
+
+		m.checkers[target.Name] = &managedChecker{checker: checker}
+		m.order = append(m.order, target.Name)
+	}
+
+	return m, nil
+}
+
+func (m *HealthCheckManager) newChecker(config HealthCheckerConfig, target TargetConfig) (*HealthChecker, error) {
+	checkerConfig := config
+	checkerConfig.URL = target.URL
+	checkerConfig.Name = target.Name
+	checkerConfig.Logger = m.logger
+	checkerConfig.PoolMaxBlockNumber = m.maxBlockNumber
+
+	checker, err := NewHealthChecker(checkerConfig, m.name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create health checker for target %q", target.Name)
+	}
+
+	return checker, nil
+}
+
+func (m *HealthCheckManager) Start(c context.Context) error {
+	m.mu.Lock()
+	m.ctx = c
+	m.started = true
+	for _, mc := range m.checkers {
+		m.startLocked(mc)
+	}
+
+	poolCtx, cancel := context.WithCancel(c)
+	m.poolCancel = cancel
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		m.runPoolEvaluator(poolCtx)
+	}()
+	m.mu.Unlock()
+
+	m.wg.Wait()
+	return nil
+}
+
+// runPoolEvaluator periodically re-evaluates every target's block number
+// against the rest of the pool, ejecting targets that fall too far behind
+// (Pool.MaxBlocksBehind, subject to Pool.MinQuorum) or whose block number
+// regressed more than Pool.ReorgTolerance since the last round.
+func (m *HealthCheckManager) runPoolEvaluator(c context.Context) {
+	ticker := time.NewTicker(m.poolEvalInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.Done():
+			return
+		case <-ticker.C:
+			m.evaluatePool()
+			ticker.Reset(m.poolEvalInterval())
+		}
+	}
+}
+
+func (m *HealthCheckManager) poolEvalInterval() time.Duration {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.checkInterval > 0 {
+		return m.checkInterval
+	}
+
+	return defaultPoolEvalInterval
+}
+
+// reorgCooldownLocked returns how long a target should stay reorg-ejected.
+// Pool.Cooldown of zero doesn't disable ejection (unlike MaxBlocksBehind);
+// it falls back to the pool evaluator's own interval, so a detected reorg
+// is guaranteed to survive until at least the next evaluation round instead
+// of being immediately eligible again. Callers must hold m.mu.
+func (m *HealthCheckManager) reorgCooldownLocked() time.Duration {
+	if m.pool.Cooldown > 0 {
+		return time.Duration(m.pool.Cooldown)
+	}
+
+	if m.checkInterval > 0 {
+		return m.checkInterval
+	}
+
+	return defaultPoolEvalInterval
+}
+
+// evaluatePool runs one round of pool-level lag/reorg evaluation.
+func (m *HealthCheckManager) evaluatePool() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+
+	type candidate struct {
+		mc          *managedChecker
+		blockNumber uint64
+	}
+
+	candidates := make([]candidate, 0, len(m.checkers))
+
+	for _, mc := range m.checkers {
+		blockNumber := mc.checker.BlockNumber()
+
+		if mc.lastBlockNumber > blockNumber && mc.lastBlockNumber-blockNumber > m.pool.ReorgTolerance {
+			mc.reorgEjectedUntil = now.Add(m.reorgCooldownLocked())
+			mc.reorgEjectedReason = fmt.Sprintf("reorg detected: block number regressed from %d to %d", mc.lastBlockNumber, blockNumber)
+		}
+
+		mc.lastBlockNumber = blockNumber
+
+		// Lag ejection is recomputed from scratch below; a target that
+		// isn't re-ejected this round is no longer lag-ejected.
+		mc.lagEjected = false
+		mc.lagEjectedReason = ""
+
+		if !mc.checker.IsHealthy() || now.Before(mc.reorgEjectedUntil) {
+			continue
+		}
+
+		candidates = append(candidates, candidate{mc: mc, blockNumber: blockNumber})
+	}
+
+	if m.pool.MaxBlocksBehind == 0 {
+		return
+	}
+
+	quorum := m.pool.MinQuorum
+	if quorum < 1 {
+		quorum = 1
+	}
+
+	if len(candidates) < quorum {
+		return
+	}
+
+	var poolMax uint64
+	for _, cand := range candidates {
+		if cand.blockNumber > poolMax {
+			poolMax = cand.blockNumber
+		}
+	}
+
+	for _, cand := range candidates {
+		lag := poolMax - cand.blockNumber
+		if lag > m.pool.MaxBlocksBehind {
+			cand.mc.lagEjected = true
+			cand.mc.lagEjectedReason = fmt.Sprintf("%d blocks behind pool (max %d allowed)", lag, m.pool.MaxBlocksBehind)
+		} else {
+			cand.mc.lagEjected = false
+			cand.mc.lagEjectedReason = ""
+		}
+	}
+}
+
+// startLocked launches the goroutine running checker.Start, tracked by
+// m.wg so Start only returns once every checker has stopped. Callers must
+// hold m.mu.
+func (m *HealthCheckManager) startLocked(mc *managedChecker) {
+	c, cancel := context.WithCancel(m.ctx)
+	mc.cancel = cancel
+
+	m.wg.Add(1)
+	go func(checker *HealthChecker) {
+		defer m.wg.Done()
+		checker.Start(c)
+	}(mc.checker)
+}
+
+func (m *HealthCheckManager) Stop(c context.Context) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.poolCancel != nil {
+		m.poolCancel()
+	}
+
+	for _, mc := range m.checkers {
+		if mc.cancel != nil {
+			mc.cancel()
+		}
+
+		if err := mc.checker.Stop(c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Reload replaces the managed target set with the one in config: checkers
+// for removed targets are stopped and discarded, checkers for newly added
+// targets are created and started (if the manager is already running), and
+// checkers for targets that still exist have their thresholds, interval and
+// checks updated in place.
+//
+// Requests in flight are unaffected: Proxy routes by looking up each
+// target's health by name through Proxy.Reload, which is driven
+// independently.
+func (m *HealthCheckManager) Reload(config HealthCheckManagerConfig) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.logger = config.Logger
+	m.checkInterval = time.Duration(config.Config.Interval)
+	m.pool = config.Pool
+
+	seen := make(map[string]bool, len(config.Targets))
+	order := make([]string, 0, len(config.Targets))
+
+	for _, target := range config.Targets {
+		seen[target.Name] = true
+		order = append(order, target.Name)
+
+		if existing, ok := m.checkers[target.Name]; ok {
+			checkerConfig := config.Config
+			checkerConfig.URL = target.URL
+			checkerConfig.Name = target.Name
+			checkerConfig.Logger = m.logger
+			checkerConfig.PoolMaxBlockNumber = m.maxBlockNumber
+
+			if err := existing.checker.UpdateConfig(checkerConfig); err != nil {
+				return errors.Wrapf(err, "failed to update health checker for target %q", target.Name)
+			}
+
+			continue
+		}
+
+		checker, err := m.newChecker(config.Config, target)
+		if err != nil {
+			return err
+		}
+
+		mc := &managedChecker{checker: checker}
+		m.checkers[target.Name] = mc
+
+		if m.started {
+			m.startLocked(mc)
+		}
+	}
+
+	for name, mc := range m.checkers {
+		if seen[name] {
+			continue
+		}
+
+		if mc.cancel != nil {
+			mc.cancel()
+		}
+
+		delete(m.checkers, name)
+	}
+
+	m.order = order
+
+	return nil
+}
+
+// Logger returns the slog.Logger passed to each HealthChecker this manager
+// creates, so a caller reloading the manager's config can carry it forward.
+func (m *HealthCheckManager) Logger() *slog.Logger {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.logger
+}
+
+// IsHealthy reports whether the named target is currently healthy: its own
+// HealthChecker must consider it healthy, and the pool evaluator must not
+// have ejected it for lagging behind the pool or for a suspected reorg.
+// Unknown targets are reported unhealthy.
+func (m *HealthCheckManager) IsHealthy(name string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	mc, ok := m.checkers[name]
+	if !ok {
+		return false
+	}
+
+	if mc.ejectedReason(time.Now()) != "" {
+		return false
+	}
+
+	return mc.checker.IsHealthy()
+}
+
+// maxBlockNumber returns the highest block number reported by any currently
+// healthy target in the pool, used by HealthChecks that eject targets
+// falling too far behind.
+func (m *HealthCheckManager) maxBlockNumber() uint64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.maxBlockNumberLocked()
+}
+
+// maxBlockNumberLocked is maxBlockNumber without acquiring m.mu, for callers
+// that already hold the read lock.
+func (m *HealthCheckManager) maxBlockNumberLocked() uint64 {
+	var max uint64
+	for _, mc := range m.checkers {
+		if !mc.checker.IsHealthy() || mc.ejectedReason(time.Now()) != "" {
+			continue
+		}
+
+		if blockNumber := mc.checker.BlockNumber(); blockNumber > max {
+			max = blockNumber
+		}
+	}
+
+	return max
+}
+
+// Probe forces a synchronous health check round across every target,
+// bounded by ctx, and returns the resulting status. Used by the /health
+// endpoint's full (non-quick) mode, where callers want a fresh result
+// instead of the last cached one.
+func (m *HealthCheckManager) Probe(c context.Context) []TargetStatus {
+	m.mu.RLock()
+	checkers := make([]*HealthChecker, 0, len(m.checkers))
+	for _, mc := range m.checkers {
+		checkers = append(checkers, mc.checker)
+	}
+	m.mu.RUnlock()
+
+	// Order doesn't matter here: every checker is probed concurrently
+	// and Status() below reports the results in config order regardless.
+
+	var wg sync.WaitGroup
+	for _, checker := range checkers {
+		wg.Add(1)
+		go func(checker *HealthChecker) {
+			defer wg.Done()
+			checker.Probe(c)
+		}(checker)
+	}
+	wg.Wait()
+
+	return m.Status()
+}
+
+// Status returns the current health of every target, in config order,
+// including the per-check breakdown, for use by metrics and the /health
+// JSON endpoint. In particular, callers that treat statuses[0] as the
+// primary target (e.g. the /health status-code classifier) depend on this
+// order being stable across calls.
+func (m *HealthCheckManager) Status() []TargetStatus {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	now := time.Now()
+	poolMax := m.maxBlockNumberLocked()
+
+	statuses := make([]TargetStatus, 0, len(m.order))
+	for _, name := range m.order {
+		mc, ok := m.checkers[name]
+		if !ok {
+			continue
+		}
+
+		checker := mc.checker
+		blockNumber := checker.BlockNumber()
+		ejectedReason := mc.ejectedReason(now)
+
+		var blockLag uint64
+		if poolMax > blockNumber {
+			blockLag = poolMax - blockNumber
+		}
+
+		statuses = append(statuses, TargetStatus{
+			Name:                checker.Name(),
+			Healthy:             checker.IsHealthy() && ejectedReason == "",
+			LastCheckedAt:       checker.LastCheckedAt(),
+			Latency:             checker.Latency().Milliseconds(),
+			BlockNumber:         blockNumber,
+			BlockLag:            blockLag,
+			PoolMaxBlock:        poolMax,
+			ConsecutiveFailures: checker.ConsecutiveFailures(),
+			EjectedReason:       ejectedReason,
+			Checks:              checker.CheckResults(),
+		})
+	}
+
+	recordPoolMetrics(m.name, statuses)
+
+	return statuses
+}
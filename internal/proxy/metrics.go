@@ -0,0 +1,54 @@
+package proxy
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	targetBlockLag = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "rpc_gateway_target_block_lag",
+		Help: "Number of blocks a target is behind the highest block number reported by a healthy target in its pool.",
+	}, []string{"pool", "target"})
+
+	poolMaxBlock = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "rpc_gateway_pool_max_block",
+		Help: "Highest block number reported by a healthy, non-ejected target in the pool.",
+	}, []string{"pool"})
+
+	targetEjected = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "rpc_gateway_target_ejected",
+		Help: "1 if the pool evaluator has ejected the target for lagging behind the pool or a suspected reorg, 0 otherwise.",
+	}, []string{"pool", "target", "reason"})
+)
+
+func init() {
+	prometheus.MustRegister(targetBlockLag, poolMaxBlock, targetEjected)
+}
+
+// recordPoolMetrics refreshes the gauges above from a freshly computed
+// Status() result. targetEjected is reset first so a target that's no
+// longer ejected, or ejected for a different reason, doesn't leave a
+// stale series behind at value 1.
+func recordPoolMetrics(pool string, statuses []TargetStatus) {
+	targetEjected.DeletePartialMatch(prometheus.Labels{"pool": pool})
+
+	for _, status := range statuses {
+		targetBlockLag.WithLabelValues(pool, status.Name).Set(float64(status.BlockLag))
+
+		ejected := 0.0
+		reason := status.EjectedReason
+		if reason != "" {
+			ejected = 1.0
+		}
+
+		targetEjected.WithLabelValues(pool, status.Name, reason).Set(ejected)
+	}
+
+	poolMaxBlock.WithLabelValues(pool).Set(float64(maxPoolBlock(statuses)))
+}
+
+func maxPoolBlock(statuses []TargetStatus) uint64 {
+	if len(statuses) == 0 {
+		return 0
+	}
+
+	return statuses[0].PoolMaxBlock
+}
@@ -0,0 +1,97 @@
+package proxy
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// Proxy is a reverse proxy that forwards requests to the first healthy
+// target in the pool, as reported by the configured HealthCheckManager. Its
+// target list is held behind an atomic pointer so Reload can swap it out
+// without dropping requests that are already in flight.
+type Proxy struct {
+	hcm     atomic.Pointer[HealthCheckManager]
+	targets atomic.Pointer[[]*target]
+	logger  *slog.Logger
+}
+
+type target struct {
+	config  TargetConfig
+	reverse *httputil.ReverseProxy
+}
+
+func NewProxy(config Config) (*Proxy, error) {
+	targets, err := buildTargets(config.Targets)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := config.Logger
+	if logger == nil {
+		logger = slog.New(slog.NewJSONHandler(os.Stderr, nil))
+	}
+
+	p := &Proxy{logger: logger}
+	p.hcm.Store(config.HealthcheckManager)
+	p.targets.Store(&targets)
+
+	return p, nil
+}
+
+func buildTargets(configs []TargetConfig) ([]*target, error) {
+	targets := make([]*target, 0, len(configs))
+
+	for _, targetConfig := range configs {
+		targetURL, err := url.Parse(targetConfig.URL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid target url %q", targetConfig.URL)
+		}
+
+		targets = append(targets, &target{
+			config:  targetConfig,
+			reverse: httputil.NewSingleHostReverseProxy(targetURL),
+		})
+	}
+
+	return targets, nil
+}
+
+// Reload swaps in the target list from config. In-flight requests keep
+// using the *target they already picked; only subsequent requests see the
+// new list.
+func (p *Proxy) Reload(config Config) error {
+	targets, err := buildTargets(config.Targets)
+	if err != nil {
+		return err
+	}
+
+	p.hcm.Store(config.HealthcheckManager)
+	p.targets.Store(&targets)
+
+	return nil
+}
+
+// ServeHTTP forwards the request to the first healthy target in the pool,
+// falling back to the next one on the list if a target is unhealthy.
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	targets := *p.targets.Load()
+	hcm := p.hcm.Load()
+
+	for _, t := range targets {
+		if hcm != nil && !hcm.IsHealthy(t.config.Name) {
+			continue
+		}
+
+		t.reverse.ServeHTTP(w, r)
+		return
+	}
+
+	p.logger.Warn("no healthy targets available", "path", r.URL.Path)
+	http.Error(w, "no healthy targets available", http.StatusServiceUnavailable)
+}
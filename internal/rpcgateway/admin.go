@@ -0,0 +1,73 @@
+package rpcgateway
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+)
+
+const defaultAdminReloadPath = "/admin/reload"
+
+// adminReloadHandler serves POST /admin/reload: it triggers the same config
+// reload a SIGHUP would, or, with ?dryRun=1, validates the new file and
+// reports the diff without applying it.
+type adminReloadHandler struct {
+	gateway *RPCGateway
+	config  AdminConfig
+}
+
+func newAdminReloadHandler(gateway *RPCGateway, config AdminConfig) *adminReloadHandler {
+	return &adminReloadHandler{gateway: gateway, config: config}
+}
+
+func (h *adminReloadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.authorized(r) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.URL.Query().Get("dryRun") == "1" {
+		diff, err := h.gateway.DryRunReload()
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(diff)
+		return
+	}
+
+	diff, err := h.gateway.Reload(r.Context())
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(reloadResponse{Reloaded: true, PortChanged: diff.PortChanged})
+}
+
+// reloadResponse is the document served by a successful POST /admin/reload.
+// PortChanged is true when the new config's listen port was left unapplied
+// because the running process can't rebind its listener without a restart.
+type reloadResponse struct {
+	Reloaded    bool `json:"reloaded"`
+	PortChanged bool `json:"portChanged,omitempty"`
+}
+
+func (h *adminReloadHandler) authorized(r *http.Request) bool {
+	const prefix = "Bearer "
+
+	auth := r.Header.Get("Authorization")
+	if len(auth) <= len(prefix) || auth[:len(prefix)] != prefix {
+		return false
+	}
+
+	token := auth[len(prefix):]
+
+	return subtle.ConstantTimeCompare([]byte(token), []byte(h.config.AuthToken)) == 1
+}
@@ -0,0 +1,61 @@
+package rpcgateway
+
+import (
+	"github.com/sygmaprotocol/rpc-gateway/internal/logging"
+	"github.com/sygmaprotocol/rpc-gateway/internal/proxy"
+	"github.com/sygmaprotocol/rpc-gateway/internal/util"
+)
+
+// RPCGatewayConfig is the YAML configuration for a single gateway instance,
+// i.e. one entry in the top-level Config.Gateways list in main.go.
+type RPCGatewayConfig struct {
+	Name           string                    `yaml:"name"`
+	Proxy          proxy.ProxyConfig         `yaml:"proxy"`
+	Targets        []proxy.TargetConfig      `yaml:"targets"`
+	HealthChecks   proxy.HealthCheckerConfig `yaml:"healthChecks"`
+	Pool           proxy.PoolConfig          `yaml:"pool"`
+	HealthEndpoint HealthEndpointConfig      `yaml:"healthEndpoint"`
+	Admin          AdminConfig               `yaml:"admin"`
+	Logging        logging.Config            `yaml:"logging"`
+}
+
+// AdminConfig configures the authenticated POST /admin/reload control
+// endpoint used to trigger a hot config reload without SIGHUP.
+type AdminConfig struct {
+	// Enabled mounts the endpoint. Disabled by default.
+	Enabled bool `yaml:"enabled"`
+
+	// Path defaults to /admin/reload.
+	Path string `yaml:"path"`
+
+	// AuthToken must be sent as `Authorization: Bearer <AuthToken>` on
+	// every request. Required when Enabled is true.
+	AuthToken string `yaml:"authToken"`
+}
+
+// HealthEndpointConfig configures the /health and /health/all aggregator
+// endpoints mounted by NewRPCGateway.
+type HealthEndpointConfig struct {
+	// Path serves the summary view: the status code and the overall
+	// healthy flag, without the per-target breakdown. Defaults to /health.
+	Path string `yaml:"path"`
+
+	// AllPath serves the full per-target JSON breakdown. Defaults to
+	// /health/all.
+	AllPath string `yaml:"allPath"`
+
+	// ProbeTimeout bounds a forced (non-?quick=1) probe. Defaults to 5s.
+	ProbeTimeout util.DurationUnmarshalled `yaml:"probeTimeout"`
+
+	// HealthyStatusCode is returned when the primary (first) target is up.
+	// Defaults to 200.
+	HealthyStatusCode int `yaml:"healthyStatusCode"`
+
+	// DegradedStatusCode is returned when the primary target is down but
+	// a fallback target is serving. Defaults to 503.
+	DegradedStatusCode int `yaml:"degradedStatusCode"`
+
+	// UnhealthyStatusCode is returned when no target is available at all.
+	// Defaults to 500.
+	UnhealthyStatusCode int `yaml:"unhealthyStatusCode"`
+}
@@ -0,0 +1,106 @@
+package rpcgateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/sygmaprotocol/rpc-gateway/internal/proxy"
+)
+
+const (
+	defaultHealthPath          = "/health"
+	defaultHealthAllPath       = "/health/all"
+	defaultHealthProbeTimeout  = 5 * time.Second
+	defaultHealthyStatusCode   = http.StatusOK
+	defaultDegradedStatusCode  = http.StatusServiceUnavailable
+	defaultUnhealthyStatusCode = http.StatusInternalServerError
+)
+
+// healthResponse is the document served by /health and /health/all.
+type healthResponse struct {
+	Healthy bool                 `json:"healthy"`
+	Targets []proxy.TargetStatus `json:"targets"`
+}
+
+// healthHandler serves the /health and /health/all aggregator endpoints.
+// /health returns the overall status code and a JSON body holding only the
+// overall healthy flag, /health/all additionally returns the full
+// per-target JSON breakdown.
+type healthHandler struct {
+	hcm     *proxy.HealthCheckManager
+	config  HealthEndpointConfig
+	verbose bool
+}
+
+func newHealthHandler(hcm *proxy.HealthCheckManager, config HealthEndpointConfig, verbose bool) *healthHandler {
+	return &healthHandler{hcm: hcm, config: config, verbose: verbose}
+}
+
+func (h *healthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var statuses []proxy.TargetStatus
+
+	if r.URL.Query().Get("quick") == "1" {
+		statuses = h.hcm.Status()
+	} else {
+		timeout := time.Duration(h.config.ProbeTimeout)
+		if timeout <= 0 {
+			timeout = defaultHealthProbeTimeout
+		}
+
+		c, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		statuses = h.hcm.Probe(c)
+	}
+
+	code, healthy := h.classify(statuses)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	response := healthResponse{Healthy: healthy}
+	if h.verbose {
+		response.Targets = statuses
+	}
+
+	// Best-effort: the status code is already written, so a marshal
+	// failure here can only affect the body.
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// classify picks the response status code: healthy when the primary (first)
+// target is up, whatever the state of the fallbacks, degraded when the primary (first) target is down but a fallback is
+// serving, unhealthy when nothing is available.
+func (h *healthHandler) classify(statuses []proxy.TargetStatus) (int, bool) {
+	if len(statuses) == 0 {
+		return h.statusCode(h.config.UnhealthyStatusCode, defaultUnhealthyStatusCode), false
+	}
+
+	anyHealthy := false
+	for _, status := range statuses {
+		if status.Healthy {
+			anyHealthy = true
+			break
+		}
+	}
+
+	if !anyHealthy {
+		return h.statusCode(h.config.UnhealthyStatusCode, defaultUnhealthyStatusCode), false
+	}
+
+	if statuses[0].Healthy {
+		return h.statusCode(h.config.HealthyStatusCode, defaultHealthyStatusCode), true
+	}
+
+	return h.statusCode(h.config.DegradedStatusCode, defaultDegradedStatusCode), true
+}
+
+func (h *healthHandler) statusCode(configured, fallback int) int {
+	if configured == 0 {
+		return fallback
+	}
+
+	return configured
+}
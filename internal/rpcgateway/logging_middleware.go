@@ -0,0 +1,30 @@
+package rpcgateway
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+)
+
+// requestBodyLogger returns a chi middleware that logs up to maxBytes of
+// every incoming request body at debug level, when include_request_body_bytes
+// is set in the http logging config. The body is restored for downstream
+// handlers.
+func requestBodyLogger(logger *slog.Logger, maxBytes int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if maxBytes <= 0 {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(io.LimitReader(r.Body, int64(maxBytes)))
+			if err == nil && len(body) > 0 {
+				logger.Debug("request body", "path", r.URL.Path, "body", string(body))
+			}
+
+			r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
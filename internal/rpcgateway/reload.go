@@ -0,0 +1,65 @@
+package rpcgateway
+
+import "reflect"
+
+// configDiff summarizes what changed between a gateway's running config and
+// a newly loaded one, as reported by a dry-run reload.
+type configDiff struct {
+	AddedTargets   []string `json:"addedTargets,omitempty"`
+	RemovedTargets []string `json:"removedTargets,omitempty"`
+
+	// HealthChecksChanged is true when the per-target health check
+	// thresholds, interval, mode or checks changed.
+	HealthChecksChanged bool `json:"healthChecksChanged,omitempty"`
+
+	// PoolChanged is true when the pool-level lag/reorg ejection settings
+	// changed.
+	PoolChanged bool `json:"poolChanged,omitempty"`
+
+	// LoggingChanged is true when the structured logging configuration
+	// changed.
+	LoggingChanged bool `json:"loggingChanged,omitempty"`
+
+	// PortChanged is true when the proxy listen port changed. This cannot
+	// be applied by a hot reload; the process must be restarted.
+	PortChanged bool `json:"portChanged,omitempty"`
+}
+
+// Unchanged reports whether the diff describes no applicable change.
+func (d configDiff) Unchanged() bool {
+	return len(d.AddedTargets) == 0 && len(d.RemovedTargets) == 0 &&
+		!d.HealthChecksChanged && !d.PoolChanged && !d.LoggingChanged && !d.PortChanged
+}
+
+func diffConfig(current, updated RPCGatewayConfig) configDiff {
+	oldNames := make(map[string]bool, len(current.Targets))
+	for _, t := range current.Targets {
+		oldNames[t.Name] = true
+	}
+
+	newNames := make(map[string]bool, len(updated.Targets))
+	for _, t := range updated.Targets {
+		newNames[t.Name] = true
+	}
+
+	var diff configDiff
+
+	for name := range newNames {
+		if !oldNames[name] {
+			diff.AddedTargets = append(diff.AddedTargets, name)
+		}
+	}
+
+	for name := range oldNames {
+		if !newNames[name] {
+			diff.RemovedTargets = append(diff.RemovedTargets, name)
+		}
+	}
+
+	diff.HealthChecksChanged = !reflect.DeepEqual(current.HealthChecks, updated.HealthChecks)
+	diff.PoolChanged = !reflect.DeepEqual(current.Pool, updated.Pool)
+	diff.LoggingChanged = !reflect.DeepEqual(current.Logging, updated.Logging)
+	diff.PortChanged = current.Proxy.Port != "" && current.Proxy.Port != updated.Proxy.Port
+
+	return diff
+}
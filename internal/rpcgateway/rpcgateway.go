@@ -3,14 +3,14 @@ package rpcgateway
 import (
 	"context"
 	"fmt"
-	"github.com/0xProject/rpc-gateway/internal/util"
-	"log/slog"
 	"net/http"
-	"os"
+	"sync"
 	"time"
 
-	"github.com/0xProject/rpc-gateway/internal/metrics"
-	"github.com/0xProject/rpc-gateway/internal/proxy"
+	"github.com/sygmaprotocol/rpc-gateway/internal/metrics"
+	"github.com/sygmaprotocol/rpc-gateway/internal/proxy"
+	"github.com/sygmaprotocol/rpc-gateway/internal/util"
+
 	"github.com/carlmjohnson/flowmatic"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -19,11 +19,14 @@ import (
 )
 
 type RPCGateway struct {
-	config  RPCGatewayConfig
-	proxy   *proxy.Proxy
-	hcm     *proxy.HealthCheckManager
-	server  *http.Server
-	metrics *metrics.Server
+	configPath string
+	config     RPCGatewayConfig
+	proxy      *proxy.Proxy
+	hcm        *proxy.HealthCheckManager
+	server     *http.Server
+	metrics    *metrics.Server
+
+	mu sync.RWMutex
 }
 
 func (r *RPCGateway) ServeHTTP(w http.ResponseWriter, req *http.Request) {
@@ -58,68 +61,172 @@ func (r *RPCGateway) Stop(c context.Context) error {
 	)
 }
 
-func NewRPCGateway(config RPCGatewayConfig, metricsServer *metrics.Server) (*RPCGateway, error) {
-	logLevel := slog.LevelWarn
-	if os.Getenv("DEBUG") == "true" {
-		logLevel = slog.LevelDebug
+// Reload re-reads the gateway's config file from disk and applies any
+// changes to the running proxy and health checkers without restarting the
+// process: newly added targets are started, removed targets are stopped,
+// and thresholds/intervals on existing ones are updated in place. The
+// proxy's target list is swapped behind an atomic pointer, so requests
+// already in flight are not dropped. Targets are matched by name, so a
+// target whose URL changed under the same name does not by itself count as
+// a change.
+//
+// A changed listen port cannot be applied this way - the http.Server is
+// already bound to the old one - so it's left untouched and reported back
+// via the returned diff's PortChanged, for the caller to surface to the
+// operator. Of a changed logging block, only the proxy's logger is
+// replaced; the health checkers and the HTTP request logging keep the
+// loggers they were built with. Every other change in diff is applied.
+func (r *RPCGateway) Reload(c context.Context) (configDiff, error) {
+	newConfig, diff, err := r.loadAndDiff()
+	if err != nil {
+		return configDiff{}, err
+	}
+
+	if diff.Unchanged() {
+		return diff, nil
+	}
+
+	if err := r.hcm.Reload(proxy.HealthCheckManagerConfig{
+		Targets: newConfig.Targets,
+		Config:  newConfig.HealthChecks,
+		Pool:    newConfig.Pool,
+		Logger:  r.hcm.Logger(),
+	}); err != nil {
+		return diff, errors.Wrap(err, "failed to reload health check manager")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if diff.PortChanged {
+		// Keep serving on the port we're actually bound to, and keep
+		// reporting it as changed until the process is restarted onto
+		// the new one.
+		newConfig.Proxy.Port = r.config.Proxy.Port
+	}
+
+	if err := r.proxy.Reload(proxy.Config{
+		Proxy:              newConfig.Proxy,
+		Targets:            newConfig.Targets,
+		HealthChecks:       newConfig.HealthChecks,
+		HealthcheckManager: r.hcm,
+		Name:               newConfig.Name,
+		Logger:             newConfig.Logging.For("proxy").Logger(),
+	}); err != nil {
+		return diff, errors.Wrap(err, "failed to reload proxy")
+	}
+
+	r.config = *newConfig
+
+	return diff, nil
+}
+
+// DryRunReload re-reads the gateway's config file and reports what a real
+// Reload would change, without applying anything. Used by the
+// `POST /admin/reload?dryRun=1` endpoint.
+func (r *RPCGateway) DryRunReload() (configDiff, error) {
+	_, diff, err := r.loadAndDiff()
+	return diff, err
+}
+
+func (r *RPCGateway) loadAndDiff() (*RPCGatewayConfig, configDiff, error) {
+	newConfig, err := util.LoadYamlFile[RPCGatewayConfig](r.configPath)
+	if err != nil {
+		return nil, configDiff{}, errors.Wrap(err, "failed to load config")
 	}
 
+	r.mu.RLock()
+	current := r.config
+	r.mu.RUnlock()
+
+	return newConfig, diffConfig(current, *newConfig), nil
+}
+
+func NewRPCGateway(config RPCGatewayConfig, metricsServer *metrics.Server) (*RPCGateway, error) {
+	httpLogging := config.Logging.For("http")
+
 	logger := httplog.NewLogger("rpc-gateway", httplog.Options{
-		JSON:           true,
-		RequestHeaders: true,
-		LogLevel:       logLevel,
+		JSON:           httpLogging.Format != "text" && httpLogging.Format != "logfmt",
+		RequestHeaders: httpLogging.IncludeRequestHeaders,
+		LogLevel:       httpLogging.Level(),
 	})
 
+	hcmLogger := config.Logging.For("healthcheck").Logger()
+
 	hcm, err := proxy.NewHealthCheckManager(
 		proxy.HealthCheckManagerConfig{
 			Targets: config.Targets,
 			Config:  config.HealthChecks,
-			Logger: slog.New(
-				slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
-					Level: logLevel,
-				})),
+			Pool:    config.Pool,
+			Logger:  hcmLogger,
 		}, config.Name)
 	if err != nil {
 		return nil, errors.Wrap(err, "healthcheckmanager failed")
 	}
 
-	proxy, err := proxy.NewProxy(
+	proxyInstance, err := proxy.NewProxy(
 		proxy.Config{
 			Proxy:              config.Proxy,
 			Targets:            config.Targets,
 			HealthChecks:       config.HealthChecks,
 			HealthcheckManager: hcm,
 			Name:               config.Name,
+			Logger:             config.Logging.For("proxy").Logger(),
 		},
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "proxy failed")
 	}
 
-	r := chi.NewRouter()
-	r.Use(httplog.RequestLogger(logger))
+	router := chi.NewRouter()
+	router.Use(httplog.RequestLogger(logger))
+	router.Use(requestBodyLogger(httpLogging.Logger(), httpLogging.IncludeRequestBodyBytes))
 
 	// Recoverer is a middleware that recovers from panics, logs the panic (and
 	// a backtrace), and returns a HTTP 500 (Internal Server Error) status if
 	// possible. Recoverer prints a request ID if one is provided.
 	//
-	r.Use(middleware.Recoverer)
+	router.Use(middleware.Recoverer)
+
+	healthPath := config.HealthEndpoint.Path
+	if healthPath == "" {
+		healthPath = defaultHealthPath
+	}
+
+	healthAllPath := config.HealthEndpoint.AllPath
+	if healthAllPath == "" {
+		healthAllPath = defaultHealthAllPath
+	}
 
-	r.Handle("/", proxy)
+	router.Handle(healthPath, newHealthHandler(hcm, config.HealthEndpoint, false))
+	router.Handle(healthAllPath, newHealthHandler(hcm, config.HealthEndpoint, true))
 
-	return &RPCGateway{
+	router.Handle("/", proxyInstance)
+
+	r := &RPCGateway{
 		config:  config,
-		proxy:   proxy,
+		proxy:   proxyInstance,
 		hcm:     hcm,
 		metrics: metricsServer,
 		server: &http.Server{
 			Addr:              fmt.Sprintf(":%s", config.Proxy.Port),
-			Handler:           r,
+			Handler:           router,
 			WriteTimeout:      time.Second * 15,
 			ReadTimeout:       time.Second * 15,
 			ReadHeaderTimeout: time.Second * 5,
 		},
-	}, nil
+	}
+
+	if config.Admin.Enabled {
+		adminPath := config.Admin.Path
+		if adminPath == "" {
+			adminPath = defaultAdminReloadPath
+		}
+
+		router.Method(http.MethodPost, adminPath, newAdminReloadHandler(r, config.Admin))
+	}
+
+	return r, nil
 }
 
 // NewRPCGatewayFromConfigFile creates an instance of RPCGateway from provided
@@ -132,6 +239,12 @@ func NewRPCGatewayFromConfigFile(s string, server *metrics.Server) (*RPCGateway,
 
 	fmt.Println("Starting RPC Gateway for " + config.Name + " on port: " + config.Proxy.Port)
 
-	// Pass the metrics server as an argument to NewRPCGateway.
-	return NewRPCGateway(*config, server)
+	gateway, err := NewRPCGateway(*config, server)
+	if err != nil {
+		return nil, err
+	}
+
+	gateway.configPath = s
+
+	return gateway, nil
 }
@@ -3,14 +3,17 @@ package main
 import (
 	"context"
 	"fmt"
-	"github.com/0xProject/rpc-gateway/internal/metrics"
-	"github.com/0xProject/rpc-gateway/internal/util"
+	"log/slog"
 	"os"
 	"os/signal"
 	"sync"
 	"syscall"
 
-	"github.com/0xProject/rpc-gateway/internal/rpcgateway"
+	"github.com/sygmaprotocol/rpc-gateway/internal/logging"
+	"github.com/sygmaprotocol/rpc-gateway/internal/metrics"
+	"github.com/sygmaprotocol/rpc-gateway/internal/rpcgateway"
+	"github.com/sygmaprotocol/rpc-gateway/internal/util"
+
 	"github.com/pkg/errors"
 	"github.com/urfave/cli/v2"
 )
@@ -22,6 +25,7 @@ type MetricsConfig struct {
 type Config struct {
 	Metrics  MetricsConfig   `yaml:"metrics"`
 	Gateways []GatewayConfig `yaml:"gateways"`
+	Logging  logging.Config  `yaml:"logging"`
 }
 
 type GatewayConfig struct {
@@ -50,6 +54,9 @@ func main() {
 				return errors.Wrap(err, "failed to load config")
 			}
 
+			logger := config.Logging.Logger()
+			slog.SetDefault(logger)
+
 			metricsServer := metrics.NewServer(metrics.Config{Port: uint(config.Metrics.Port)})
 
 			var wg sync.WaitGroup
@@ -59,7 +66,7 @@ func main() {
 					defer wg.Done()
 					err := startGateway(c, gwConfig, metricsServer)
 					if err != nil {
-						fmt.Fprintf(os.Stderr, "error starting gateway '%s': %v\n", gwConfig.Name, err)
+						logger.Error("error starting gateway", "gateway", gwConfig.Name, "error", err)
 					}
 				}(gatewayConfig)
 			}
@@ -80,6 +87,8 @@ func startGateway(ctx context.Context, config GatewayConfig, server *metrics.Ser
 		return errors.Wrap(err, "rpc-gateway failed")
 	}
 
+	go reloadOnSIGHUP(ctx, config.Name, service)
+
 	err = service.Start(ctx)
 	if err != nil {
 		return errors.Wrap(err, "cannot start service")
@@ -88,3 +97,30 @@ func startGateway(ctx context.Context, config GatewayConfig, server *metrics.Ser
 	<-ctx.Done()
 	return errors.Wrap(service.Stop(ctx), "cannot stop service")
 }
+
+// reloadOnSIGHUP reparses the gateway's YAML config file and applies any
+// changes every time the process receives SIGHUP, until ctx is done. A
+// failed reload is logged and left for the operator to retry; the gateway
+// keeps running on its previous config.
+func reloadOnSIGHUP(ctx context.Context, name string, service *rpcgateway.RPCGateway) {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	defer signal.Stop(sighup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sighup:
+			diff, err := service.Reload(ctx)
+			if err != nil {
+				slog.Error("error reloading gateway", "gateway", name, "error", err)
+				continue
+			}
+
+			if diff.PortChanged {
+				slog.Warn("reload cannot apply a changed listen port; restart the process to pick it up", "gateway", name)
+			}
+		}
+	}
+}